@@ -0,0 +1,184 @@
+package envparser
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// optionalMarker prefixes a Path produced by Optional so LoadFile can tell
+// it apart from a required path without widening the public API.
+const optionalMarker = "\x00optional:"
+
+// Path identifies a dotenv file to load. Plain string literals convert to
+// Path implicitly; wrap a path in Optional to make a missing file
+// non-fatal.
+type Path string
+
+// Optional marks path as non-fatal: if it doesn't exist, LoadFile skips it
+// instead of returning an error. Useful for layering an untracked
+// ".env.local" on top of a committed ".env".
+func Optional(path string) Path {
+	return Path(optionalMarker + path)
+}
+
+func (p Path) split() (path string, optional bool) {
+	if s := string(p); strings.HasPrefix(s, optionalMarker) {
+		return s[len(optionalMarker):], true
+	}
+	return string(p), false
+}
+
+// Parser loads dotenv files into the process environment.
+type Parser struct {
+	overload bool
+}
+
+// ParserOption configures a Parser.
+type ParserOption func(*Parser)
+
+// WithOverload makes values loaded from file take precedence over
+// variables already present in the process environment. Without it, the
+// process environment always wins, matching the usual twelve-factor
+// "real env overrides .env" convention.
+func WithOverload() ParserOption {
+	return func(p *Parser) {
+		p.overload = true
+	}
+}
+
+// NewParser returns a Parser configured by opts.
+func NewParser(opts ...ParserOption) *Parser {
+	p := &Parser{}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// LoadFile reads each of paths in order and sets the resulting key/value
+// pairs into the process environment via os.Setenv, for subsequent Parse
+// and ParseStruct calls to see. Unless the Parser was built with
+// WithOverload, a variable already present in the process environment
+// before this call is left untouched, though a later file may still
+// override a value set by an earlier one in the same call. ${VAR}
+// references are interpolated against the environment as it stands at
+// that point, so they always see the value a direct Parse of VAR would.
+func (p *Parser) LoadFile(paths ...Path) error {
+	protected := map[string]struct{}{}
+	if !p.overload {
+		for _, kv := range os.Environ() {
+			if i := strings.IndexByte(kv, '='); i >= 0 {
+				protected[kv[:i]] = struct{}{}
+			}
+		}
+	}
+
+	for _, path := range paths {
+		raw, optional := path.split()
+
+		data, err := os.ReadFile(raw)
+		if err != nil {
+			if optional && os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+
+		if err := loadDotenv(string(data), protected); err != nil {
+			return fmt.Errorf("%s: %w", raw, err)
+		}
+	}
+
+	return nil
+}
+
+// LoadFile reads each of paths, using the process environment's value
+// whenever a key is set in both the process environment and the files.
+func LoadFile(paths ...Path) error {
+	return NewParser().LoadFile(paths...)
+}
+
+// LoadFiles is an explicit alias for LoadFile, for call sites that read
+// more clearly naming multiple files.
+func LoadFiles(paths ...Path) error {
+	return LoadFile(paths...)
+}
+
+// Overload reads each of paths, letting file values override variables
+// already present in the process environment.
+func Overload(paths ...Path) error {
+	return NewParser(WithOverload()).LoadFile(paths...)
+}
+
+// loadDotenv parses dotenv-style content and applies it directly to the
+// process environment, line by line, skipping any key present in
+// protected. Applying each line immediately (rather than batching) keeps
+// ${VAR} interpolation in later lines consistent with the precedence
+// already resolved for earlier ones.
+func loadDotenv(content string, protected map[string]struct{}) error {
+	lines := strings.Split(content, "\n")
+
+	for i, line := range lines {
+		line = strings.TrimSpace(strings.TrimRight(line, "\r"))
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		line = strings.TrimSpace(strings.TrimPrefix(line, "export "))
+
+		eq := strings.IndexByte(line, '=')
+		if eq < 0 {
+			return fmt.Errorf("line %d: missing '='", i+1)
+		}
+
+		key := strings.TrimSpace(line[:eq])
+		if key == "" {
+			return fmt.Errorf("line %d: empty key", i+1)
+		}
+
+		val, err := parseDotenvValue(strings.TrimSpace(line[eq+1:]))
+		if err != nil {
+			return fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		if _, ok := protected[key]; ok {
+			continue
+		}
+		os.Setenv(key, val)
+	}
+
+	return nil
+}
+
+// escapedDollar stands in for a `\$` escape while strconv.Unquote runs, so
+// that an escaped dollar survives both unquoting and interpolation as a
+// literal "$" instead of being treated as Go-escape garbage or the start
+// of a variable reference.
+const escapedDollar = "\x00$\x00"
+
+func parseDotenvValue(raw string) (string, error) {
+	switch {
+	case len(raw) >= 2 && raw[0] == '"' && raw[len(raw)-1] == '"':
+		unquoted, err := strconv.Unquote(strings.ReplaceAll(raw, `\$`, escapedDollar))
+		if err != nil {
+			return "", err
+		}
+		return strings.ReplaceAll(interpolate(unquoted), escapedDollar, "$"), nil
+
+	case len(raw) >= 2 && raw[0] == '\'' && raw[len(raw)-1] == '\'':
+		// Single-quoted values are taken literally, with no interpolation.
+		return raw[1 : len(raw)-1], nil
+
+	default:
+		if idx := strings.Index(raw, " #"); idx >= 0 {
+			raw = strings.TrimSpace(raw[:idx])
+		}
+		return interpolate(raw), nil
+	}
+}
+
+func interpolate(s string) string {
+	return os.Expand(s, os.Getenv)
+}
@@ -0,0 +1,122 @@
+package envparser
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/m0t0k1ch1/go-envparser/internal/testutils"
+)
+
+func writeEnvFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadFile(t *testing.T) {
+	dir := t.TempDir()
+	path := writeEnvFile(t, dir, ".env", ""+
+		"# a comment\n"+
+		"export NAME=app\n"+
+		"QUOTED=\"hello\\nworld\"\n"+
+		"LITERAL='${NAME}'\n"+
+		"GREETING=hi ${NAME} # trailing comment\n",
+	)
+
+	defer os.Clearenv()
+
+	if err := LoadFile(Path(path)); err != nil {
+		t.Fatal(err)
+	}
+
+	testutils.Equal(t, "app", os.Getenv("NAME"))
+	testutils.Equal(t, "hello\nworld", os.Getenv("QUOTED"))
+	testutils.Equal(t, "${NAME}", os.Getenv("LITERAL"))
+	testutils.Equal(t, "hi app", os.Getenv("GREETING"))
+}
+
+func TestLoadFileProcessEnvWins(t *testing.T) {
+	dir := t.TempDir()
+	path := writeEnvFile(t, dir, ".env", "NAME=from-file\nGREETING=hi ${NAME}\n")
+
+	os.Setenv("NAME", "from-process")
+	defer os.Clearenv()
+
+	if err := LoadFile(Path(path)); err != nil {
+		t.Fatal(err)
+	}
+
+	testutils.Equal(t, "from-process", os.Getenv("NAME"))
+	testutils.Equal(t, "hi from-process", os.Getenv("GREETING"))
+}
+
+func TestLoadFileEscapedDollarIsNotInterpolated(t *testing.T) {
+	dir := t.TempDir()
+	path := writeEnvFile(t, dir, ".env", `SECRET="p@ss\$word"`+"\n")
+
+	defer os.Clearenv()
+
+	if err := LoadFile(Path(path)); err != nil {
+		t.Fatal(err)
+	}
+
+	testutils.Equal(t, "p@ss$word", os.Getenv("SECRET"))
+}
+
+func TestLoadFileEmptyKeyFailed(t *testing.T) {
+	dir := t.TempDir()
+	path := writeEnvFile(t, dir, ".env", "=value\n")
+
+	err := LoadFile(Path(path))
+	testutils.Equal(t, true, err != nil)
+}
+
+func TestOverloadFileWins(t *testing.T) {
+	dir := t.TempDir()
+	path := writeEnvFile(t, dir, ".env", "NAME=from-file\n")
+
+	os.Setenv("NAME", "from-process")
+	defer os.Clearenv()
+
+	if err := Overload(Path(path)); err != nil {
+		t.Fatal(err)
+	}
+
+	testutils.Equal(t, "from-file", os.Getenv("NAME"))
+}
+
+func TestLoadFileMissingOptionalIsNonFatal(t *testing.T) {
+	dir := t.TempDir()
+
+	defer os.Clearenv()
+
+	err := LoadFile(Optional(filepath.Join(dir, ".env.local")))
+	testutils.Equal(t, true, err == nil)
+}
+
+func TestLoadFileMissingRequiredIsFatal(t *testing.T) {
+	dir := t.TempDir()
+
+	err := LoadFile(Path(filepath.Join(dir, ".env")))
+	testutils.Equal(t, true, err != nil)
+}
+
+func TestLoadFileLayering(t *testing.T) {
+	dir := t.TempDir()
+	base := writeEnvFile(t, dir, ".env", "NAME=base\nPORT=8080\n")
+	local := writeEnvFile(t, dir, ".env.local", "NAME=local\n")
+
+	defer os.Clearenv()
+
+	if err := LoadFile(Path(base), Path(local)); err != nil {
+		t.Fatal(err)
+	}
+
+	testutils.Equal(t, "local", os.Getenv("NAME"))
+	testutils.Equal(t, "8080", os.Getenv("PORT"))
+}
@@ -0,0 +1,60 @@
+package envparser
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// InvalidArgError is returned when the argument passed to Parse is unusable,
+// e.g. nil, a non-pointer, or a nil pointer.
+type InvalidArgError struct {
+	Msg string
+}
+
+func (e *InvalidArgError) Error() string {
+	return fmt.Sprintf("invalid argument: %s", e.Msg)
+}
+
+// UnsupportedTypeError is returned when v points to a type that Parse
+// doesn't know how to populate.
+type UnsupportedTypeError struct {
+	Type reflect.Type
+}
+
+func (e *UnsupportedTypeError) Error() string {
+	return fmt.Sprintf("unsupported type: %s", e.Type)
+}
+
+// NotPresentError is returned when the requested environment variable is
+// not set.
+type NotPresentError struct {
+	Key string
+}
+
+func (e *NotPresentError) Error() string {
+	return fmt.Sprintf("%s is not present", e.Key)
+}
+
+// ParseError is returned when the environment variable is present but its
+// value cannot be parsed into the target type. Index is the offending
+// element's position when Key refers to a slice-typed variable, and is -1
+// otherwise.
+type ParseError struct {
+	Key   string
+	Value string
+	Index int
+	Err   error
+}
+
+func (e *ParseError) Error() string {
+	key := e.Key
+	if e.Index >= 0 {
+		key = fmt.Sprintf("%s[%d]", e.Key, e.Index)
+	}
+
+	return fmt.Sprintf("failed to parse %s=%q: %v", key, e.Value, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
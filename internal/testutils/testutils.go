@@ -0,0 +1,26 @@
+// Package testutils provides small assertion helpers shared by this
+// module's test files.
+package testutils
+
+import (
+	"strings"
+	"testing"
+)
+
+// Equal fails the test if want and got differ.
+func Equal[T comparable](t *testing.T, want, got T) {
+	t.Helper()
+
+	if want != got {
+		t.Errorf("want %v, got %v", want, got)
+	}
+}
+
+// Contains fails the test if s does not contain substr.
+func Contains(t *testing.T, s, substr string) {
+	t.Helper()
+
+	if !strings.Contains(s, substr) {
+		t.Errorf("%q does not contain %q", s, substr)
+	}
+}
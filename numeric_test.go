@@ -0,0 +1,125 @@
+package envparser
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/m0t0k1ch1/go-envparser/internal/testutils"
+)
+
+func TestParseAsBool(t *testing.T) {
+	cases := []struct {
+		in  string
+		out bool
+	}{{
+		in:  "true",
+		out: true,
+	}, {
+		in:  "false",
+		out: false,
+	}}
+
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			os.Setenv(testEnvKey, c.in)
+			defer os.Clearenv()
+
+			var b bool
+			if err := Parse(testEnvKey, &b); err != nil {
+				t.Error(err)
+			} else {
+				testutils.Equal(t, c.out, b)
+			}
+		})
+	}
+}
+
+func TestParseAsBoolFailedWithParseError(t *testing.T) {
+	os.Setenv(testEnvKey, "yes")
+	defer os.Clearenv()
+
+	var b bool
+	var perr *ParseError
+	var nerr *strconv.NumError
+	err := Parse(testEnvKey, &b)
+	testutils.Equal(t, true, errors.As(err, &perr))
+	testutils.Equal(t, true, errors.As(err, &nerr))
+	testutils.Contains(t, err.Error(), "invalid syntax")
+}
+
+func TestParseAsFloat64(t *testing.T) {
+	os.Setenv(testEnvKey, "3.14")
+	defer os.Clearenv()
+
+	var f float64
+	if err := Parse(testEnvKey, &f); err != nil {
+		t.Error(err)
+	} else {
+		testutils.Equal(t, 3.14, f)
+	}
+}
+
+func TestParseAsFloat32FailedWithParseError(t *testing.T) {
+	os.Setenv(testEnvKey, "not-a-float")
+	defer os.Clearenv()
+
+	var f float32
+	var perr *ParseError
+	var nerr *strconv.NumError
+	err := Parse(testEnvKey, &f)
+	testutils.Equal(t, true, errors.As(err, &perr))
+	testutils.Equal(t, true, errors.As(err, &nerr))
+	testutils.Contains(t, err.Error(), "invalid syntax")
+}
+
+func TestParseAsSizedInt(t *testing.T) {
+	os.Setenv(testEnvKey, "127")
+	defer os.Clearenv()
+
+	var i8 int8
+	if err := Parse(testEnvKey, &i8); err != nil {
+		t.Error(err)
+	} else {
+		testutils.Equal(t, int8(127), i8)
+	}
+}
+
+func TestParseAsSizedIntFailedWithParseError(t *testing.T) {
+	os.Setenv(testEnvKey, "128")
+	defer os.Clearenv()
+
+	var i8 int8
+	var perr *ParseError
+	var nerr *strconv.NumError
+	err := Parse(testEnvKey, &i8)
+	testutils.Equal(t, true, errors.As(err, &perr))
+	testutils.Equal(t, true, errors.As(err, &nerr))
+	testutils.Contains(t, err.Error(), "value out of range")
+}
+
+func TestParseAsSizedUint(t *testing.T) {
+	os.Setenv(testEnvKey, "255")
+	defer os.Clearenv()
+
+	var u8 uint8
+	if err := Parse(testEnvKey, &u8); err != nil {
+		t.Error(err)
+	} else {
+		testutils.Equal(t, uint8(255), u8)
+	}
+}
+
+func TestParseAsSizedUintFailedWithParseError(t *testing.T) {
+	os.Setenv(testEnvKey, "256")
+	defer os.Clearenv()
+
+	var u8 uint8
+	var perr *ParseError
+	var nerr *strconv.NumError
+	err := Parse(testEnvKey, &u8)
+	testutils.Equal(t, true, errors.As(err, &perr))
+	testutils.Equal(t, true, errors.As(err, &nerr))
+	testutils.Contains(t, err.Error(), "value out of range")
+}
@@ -0,0 +1,35 @@
+package envparser
+
+import "reflect"
+
+// options holds the optional behavior configurable via Option.
+type options struct {
+	separator string
+	funcs     map[reflect.Type]ParserFunc
+}
+
+func defaultOptions() *options {
+	return &options{
+		separator: ",",
+	}
+}
+
+// Option configures optional behavior of Parse.
+type Option func(*options)
+
+// WithSeparator overrides the default "," separator used to split
+// slice-typed environment variables into elements.
+func WithSeparator(sep string) Option {
+	return func(o *options) {
+		o.separator = sep
+	}
+}
+
+// WithFuncs supplies per-call parsers, keyed by the concrete type they
+// produce. A parser supplied here takes precedence over one registered
+// globally via RegisterParser for the same type.
+func WithFuncs(funcs map[reflect.Type]ParserFunc) Option {
+	return func(o *options) {
+		o.funcs = funcs
+	}
+}
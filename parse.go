@@ -0,0 +1,168 @@
+// Package envparser populates Go values from environment variables.
+package envparser
+
+import (
+	"encoding"
+	"os"
+	"reflect"
+	"strconv"
+)
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// Parse looks up the environment variable named by key and stores it into
+// the value pointed to by v. v must be a non-nil pointer to a supported
+// type. Opts configure optional behavior, such as the separator used for
+// slice-typed targets; see WithSeparator.
+func Parse(key string, v interface{}, opts ...Option) error {
+	if v == nil {
+		return &InvalidArgError{Msg: "v cannot be nil"}
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr {
+		return &InvalidArgError{Msg: "v cannot be non-pointer " + rv.Type().String()}
+	}
+	if rv.IsNil() {
+		return &InvalidArgError{Msg: "v cannot be nil " + rv.Type().String()}
+	}
+
+	o := defaultOptions()
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	elem := rv.Elem()
+	if !supportedKind(elem.Kind()) && !hasCustomHandler(elem, o) {
+		return &UnsupportedTypeError{Type: elem.Type()}
+	}
+
+	val, ok := os.LookupEnv(key)
+	if !ok {
+		return &NotPresentError{Key: key}
+	}
+
+	return setValue(key, val, elem, o)
+}
+
+// ParseWithFuncs behaves like Parse, but additionally consults funcs,
+// keyed by the concrete type they produce, when populating v. A parser
+// supplied here takes precedence over one registered globally via
+// RegisterParser for the same type.
+func ParseWithFuncs(key string, v interface{}, funcs map[reflect.Type]ParserFunc, opts ...Option) error {
+	return Parse(key, v, append(opts, WithFuncs(funcs))...)
+}
+
+func supportedKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.String,
+		reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64,
+		reflect.Slice:
+		return true
+	default:
+		return false
+	}
+}
+
+// hasCustomHandler reports whether elem's type can be populated by a
+// registered ParserFunc (explicit WithFuncs or global RegisterParser) or by
+// encoding.TextUnmarshaler, independently of whether its Kind is one
+// setValue's built-in switch understands.
+func hasCustomHandler(elem reflect.Value, o *options) bool {
+	t := elem.Type()
+
+	if o.funcs != nil {
+		if _, ok := o.funcs[t]; ok {
+			return true
+		}
+	}
+	if _, ok := globalParsers[t]; ok {
+		return true
+	}
+
+	return elem.CanAddr() && reflect.PointerTo(t).Implements(textUnmarshalerType)
+}
+
+func setValue(key, val string, elem reflect.Value, o *options) error {
+	if fn, ok := customParserFor(elem.Type(), o); ok {
+		return setValueWithParserFunc(key, val, elem, fn)
+	}
+	if elem.CanAddr() {
+		if tu, ok := elem.Addr().Interface().(encoding.TextUnmarshaler); ok {
+			if err := tu.UnmarshalText([]byte(val)); err != nil {
+				return &ParseError{Key: key, Value: val, Index: -1, Err: err}
+			}
+			return nil
+		}
+	}
+
+	switch elem.Kind() {
+	case reflect.String:
+		elem.SetString(val)
+		return nil
+
+	case reflect.Bool:
+		b, err := strconv.ParseBool(val)
+		if err != nil {
+			return &ParseError{Key: key, Value: val, Index: -1, Err: err}
+		}
+		elem.SetBool(b)
+		return nil
+
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(val, elem.Type().Bits())
+		if err != nil {
+			return &ParseError{Key: key, Value: val, Index: -1, Err: err}
+		}
+		elem.SetFloat(f)
+		return nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(val, 10, elem.Type().Bits())
+		if err != nil {
+			return &ParseError{Key: key, Value: val, Index: -1, Err: err}
+		}
+		elem.SetInt(i)
+		return nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(val, 10, elem.Type().Bits())
+		if err != nil {
+			return &ParseError{Key: key, Value: val, Index: -1, Err: err}
+		}
+		elem.SetUint(u)
+		return nil
+
+	case reflect.Slice:
+		return setSliceValue(key, val, elem, o)
+
+	default:
+		return &UnsupportedTypeError{Type: elem.Type()}
+	}
+}
+
+// customParserFor looks up a ParserFunc for t, preferring one supplied via
+// WithFuncs over one registered globally via RegisterParser.
+func customParserFor(t reflect.Type, o *options) (ParserFunc, bool) {
+	if o.funcs != nil {
+		if fn, ok := o.funcs[t]; ok {
+			return fn, true
+		}
+	}
+
+	fn, ok := globalParsers[t]
+	return fn, ok
+}
+
+func setValueWithParserFunc(key, val string, elem reflect.Value, fn ParserFunc) error {
+	result, err := fn(val)
+	if err != nil {
+		return &ParseError{Key: key, Value: val, Index: -1, Err: err}
+	}
+
+	elem.Set(reflect.ValueOf(result))
+	return nil
+}
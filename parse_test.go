@@ -40,11 +40,28 @@ func TestParseFailedWithInvalidArgError(t *testing.T) {
 }
 
 func TestParseFailedWithUnsupportedTypeError(t *testing.T) {
-	var b bool
-	var uterr *UnsupportedTypeError
-	err := Parse(testEnvKey, &b)
-	testutils.Equal(t, true, errors.As(err, &uterr))
-	testutils.Contains(t, err.Error(), "unsupported type: bool")
+	cases := []struct {
+		name string
+		v    interface{}
+	}{{
+		name: "chan",
+		v:    new(chan int),
+	}, {
+		name: "func",
+		v:    new(func()),
+	}, {
+		name: "complex128",
+		v:    new(complex128),
+	}}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var uterr *UnsupportedTypeError
+			err := Parse(testEnvKey, c.v)
+			testutils.Equal(t, true, errors.As(err, &uterr))
+			testutils.Contains(t, err.Error(), "unsupported type: "+c.name)
+		})
+	}
 }
 
 func TestParseFailedWithNotPresentError(t *testing.T) {
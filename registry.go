@@ -0,0 +1,23 @@
+package envparser
+
+import "reflect"
+
+// ParserFunc parses a raw environment variable value into a value of some
+// concrete type, returned as interface{} so it can be stored alongside
+// parsers for other types in a single registry.
+type ParserFunc func(string) (interface{}, error)
+
+// globalParsers holds parsers registered via RegisterParser, keyed by the
+// concrete type they produce.
+var globalParsers = map[reflect.Type]ParserFunc{}
+
+// RegisterParser teaches envparser how to hydrate values of type T from a
+// raw environment variable string, for types the standard library doesn't
+// cover out of the box (time.Duration, *url.URL, uuid.UUID, enum types,
+// and so on). The parser is consulted by both Parse and ParseStruct.
+func RegisterParser[T any](fn func(string) (T, error)) {
+	var zero T
+	globalParsers[reflect.TypeOf(&zero).Elem()] = func(s string) (interface{}, error) {
+		return fn(s)
+	}
+}
@@ -0,0 +1,87 @@
+package envparser
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/m0t0k1ch1/go-envparser/internal/testutils"
+)
+
+type testLevel int
+
+func (l *testLevel) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "low":
+		*l = 1
+	case "high":
+		*l = 2
+	default:
+		return fmt.Errorf("unknown level: %s", text)
+	}
+	return nil
+}
+
+func TestParseAsRegisteredType(t *testing.T) {
+	RegisterParser(func(s string) (time.Duration, error) {
+		return time.ParseDuration(s)
+	})
+
+	os.Setenv(testEnvKey, "1500ms")
+	defer os.Clearenv()
+
+	var d time.Duration
+	if err := Parse(testEnvKey, &d); err != nil {
+		t.Error(err)
+	} else {
+		testutils.Equal(t, 1500*time.Millisecond, d)
+	}
+}
+
+func TestParseAsTextUnmarshaler(t *testing.T) {
+	os.Setenv(testEnvKey, "high")
+	defer os.Clearenv()
+
+	var l testLevel
+	if err := Parse(testEnvKey, &l); err != nil {
+		t.Error(err)
+	} else {
+		testutils.Equal(t, testLevel(2), l)
+	}
+}
+
+func TestParseAsTextUnmarshalerFailedWithParseError(t *testing.T) {
+	os.Setenv(testEnvKey, "medium")
+	defer os.Clearenv()
+
+	var l testLevel
+	var perr *ParseError
+	err := Parse(testEnvKey, &l)
+	testutils.Equal(t, true, errors.As(err, &perr))
+	testutils.Contains(t, err.Error(), "unknown level: medium")
+}
+
+func TestParseWithFuncsOverridesGlobalParser(t *testing.T) {
+	RegisterParser(func(s string) (time.Duration, error) {
+		return time.ParseDuration(s)
+	})
+
+	os.Setenv(testEnvKey, "2")
+	defer os.Clearenv()
+
+	funcs := map[reflect.Type]ParserFunc{
+		reflect.TypeOf(time.Duration(0)): func(s string) (interface{}, error) {
+			return time.Duration(2 * time.Second), nil
+		},
+	}
+
+	var d time.Duration
+	if err := ParseWithFuncs(testEnvKey, &d, funcs); err != nil {
+		t.Error(err)
+	} else {
+		testutils.Equal(t, 2*time.Second, d)
+	}
+}
@@ -0,0 +1,43 @@
+package envparser
+
+import (
+	"reflect"
+	"strings"
+)
+
+func setSliceValue(key, val string, elem reflect.Value, o *options) error {
+	parts := splitEnvValue(val, o.separator)
+
+	slice := reflect.MakeSlice(elem.Type(), len(parts), len(parts))
+	for i, part := range parts {
+		if err := setValue(key, part, slice.Index(i), o); err != nil {
+			if perr, ok := err.(*ParseError); ok {
+				perr.Index = i
+			}
+			return err
+		}
+	}
+
+	elem.Set(slice)
+	return nil
+}
+
+// splitEnvValue splits val on sep, trims surrounding whitespace from each
+// element, and drops the phantom empty element produced by a single
+// trailing separator. An empty val yields an empty (non-nil) slice.
+func splitEnvValue(val, sep string) []string {
+	if val == "" {
+		return []string{}
+	}
+
+	parts := strings.Split(val, sep)
+	if len(parts) > 1 && strings.TrimSpace(parts[len(parts)-1]) == "" {
+		parts = parts[:len(parts)-1]
+	}
+
+	for i, part := range parts {
+		parts[i] = strings.TrimSpace(part)
+	}
+
+	return parts
+}
@@ -0,0 +1,90 @@
+package envparser
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/m0t0k1ch1/go-envparser/internal/testutils"
+)
+
+func TestParseAsStringSlice(t *testing.T) {
+	cases := []struct {
+		in  string
+		out []string
+	}{{
+		in:  "a,b,c",
+		out: []string{"a", "b", "c"},
+	}, {
+		in:  "a, b , c",
+		out: []string{"a", "b", "c"},
+	}, {
+		in:  "a,b,c,",
+		out: []string{"a", "b", "c"},
+	}, {
+		in:  "",
+		out: []string{},
+	}}
+
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			os.Setenv(testEnvKey, c.in)
+			defer os.Clearenv()
+
+			var s []string
+			if err := Parse(testEnvKey, &s); err != nil {
+				t.Error(err)
+			} else {
+				testutils.Equal(t, true, sliceEqual(c.out, s))
+			}
+		})
+	}
+}
+
+func TestParseAsIntSlice(t *testing.T) {
+	os.Setenv(testEnvKey, "1, 2 , 3")
+	defer os.Clearenv()
+
+	var s []int
+	if err := Parse(testEnvKey, &s); err != nil {
+		t.Error(err)
+	} else {
+		testutils.Equal(t, true, sliceEqual([]int{1, 2, 3}, s))
+	}
+}
+
+func TestParseAsUintSliceWithSeparator(t *testing.T) {
+	os.Setenv(testEnvKey, "1;2;3")
+	defer os.Clearenv()
+
+	var s []uint
+	if err := Parse(testEnvKey, &s, WithSeparator(";")); err != nil {
+		t.Error(err)
+	} else {
+		testutils.Equal(t, true, sliceEqual([]uint{1, 2, 3}, s))
+	}
+}
+
+func TestParseAsIntSliceFailedWithParseError(t *testing.T) {
+	os.Setenv(testEnvKey, "1,zero,3")
+	defer os.Clearenv()
+
+	var s []int
+	var perr *ParseError
+	err := Parse(testEnvKey, &s)
+	testutils.Equal(t, true, errors.As(err, &perr))
+	testutils.Equal(t, 1, perr.Index)
+	testutils.Contains(t, err.Error(), "GO_ENVPARSER_TEST[1]")
+}
+
+func sliceEqual[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
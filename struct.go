@@ -0,0 +1,116 @@
+package envparser
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"strings"
+)
+
+const (
+	tagEnv        = "env"
+	tagEnvDefault = "envDefault"
+	tagEnvPrefix  = "envPrefix"
+
+	envTagModifierRequired = "required"
+)
+
+// ParseStruct populates the fields of the struct pointed to by v from
+// environment variables, based on `env:"NAME"` tags.
+//
+// A field tagged `env:"NAME,required"` causes an error to be reported when
+// NAME is not present. A field tagged `envDefault:"VALUE"` falls back to
+// VALUE when NAME is not present. Nested structs are walked recursively,
+// and an `envPrefix:"PFX_"` tag on a nested struct field is prepended to
+// the env names of its own fields.
+//
+// Errors encountered while populating individual fields are aggregated
+// rather than causing ParseStruct to return on the first failure; the
+// returned error unwraps to []error via errors.Join, so callers can
+// inspect every missing/invalid variable at once.
+func ParseStruct(v interface{}) error {
+	if v == nil {
+		return &InvalidArgError{Msg: "v cannot be nil"}
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr {
+		return &InvalidArgError{Msg: "v cannot be non-pointer " + rv.Type().String()}
+	}
+	if rv.IsNil() {
+		return &InvalidArgError{Msg: "v cannot be nil " + rv.Type().String()}
+	}
+
+	elem := rv.Elem()
+	if elem.Kind() != reflect.Struct {
+		return &InvalidArgError{Msg: "v must point to a struct, not " + elem.Kind().String()}
+	}
+
+	errs := parseStructFields(elem, "")
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	return nil
+}
+
+func parseStructFields(rv reflect.Value, prefix string) []error {
+	var errs []error
+
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fv := rv.Field(i)
+		if !fv.CanSet() {
+			continue
+		}
+
+		tag, ok := field.Tag.Lookup(tagEnv)
+		if !ok {
+			// A struct field with no env tag of its own is a nested
+			// group of fields, not a leaf value.
+			if fv.Kind() == reflect.Struct {
+				childPrefix := prefix
+				if p, ok := field.Tag.Lookup(tagEnvPrefix); ok {
+					childPrefix += p
+				}
+				errs = append(errs, parseStructFields(fv, childPrefix)...)
+			}
+			continue
+		}
+
+		name, required := parseEnvTag(tag)
+		key := prefix + name
+
+		val, present := os.LookupEnv(key)
+		if !present {
+			if def, ok := field.Tag.Lookup(tagEnvDefault); ok {
+				val, present = def, true
+			} else if required {
+				errs = append(errs, &NotPresentError{Key: key})
+				continue
+			} else {
+				continue
+			}
+		}
+
+		if err := setValue(key, val, fv, defaultOptions()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+func parseEnvTag(tag string) (name string, required bool) {
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+
+	for _, modifier := range parts[1:] {
+		if modifier == envTagModifierRequired {
+			required = true
+		}
+	}
+
+	return name, required
+}
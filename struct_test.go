@@ -0,0 +1,70 @@
+package envparser
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/m0t0k1ch1/go-envparser/internal/testutils"
+)
+
+func TestParseStructFailedWithInvalidArgError(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		var iaerr *InvalidArgError
+		err := ParseStruct(nil)
+		testutils.Equal(t, true, errors.As(err, &iaerr))
+		testutils.Contains(t, err.Error(), "v cannot be nil")
+	})
+
+	t.Run("non-struct", func(t *testing.T) {
+		var iaerr *InvalidArgError
+		var s string
+		err := ParseStruct(&s)
+		testutils.Equal(t, true, errors.As(err, &iaerr))
+		testutils.Contains(t, err.Error(), "v must point to a struct")
+	})
+}
+
+func TestParseStruct(t *testing.T) {
+	type Nested struct {
+		Port int `env:"PORT"`
+	}
+
+	type Config struct {
+		Name    string `env:"NAME"`
+		Timeout int    `env:"TIMEOUT" envDefault:"30"`
+		DB      Nested `envPrefix:"DB_"`
+	}
+
+	os.Setenv("NAME", "app")
+	os.Setenv("DB_PORT", "5432")
+	defer os.Clearenv()
+
+	var c Config
+	if err := ParseStruct(&c); err != nil {
+		t.Error(err)
+		return
+	}
+
+	testutils.Equal(t, "app", c.Name)
+	testutils.Equal(t, 30, c.Timeout)
+	testutils.Equal(t, 5432, c.DB.Port)
+}
+
+func TestParseStructFailedWithAggregatedErrors(t *testing.T) {
+	type Config struct {
+		Name string `env:"NAME,required"`
+		Port int    `env:"PORT,required"`
+	}
+
+	defer os.Clearenv()
+
+	var c Config
+	var nperr *NotPresentError
+	err := ParseStruct(&c)
+	testutils.Equal(t, true, errors.As(err, &nperr))
+
+	var joined interface{ Unwrap() []error }
+	testutils.Equal(t, true, errors.As(err, &joined))
+	testutils.Equal(t, 2, len(joined.Unwrap()))
+}